@@ -86,7 +86,25 @@ export default defineConfig({
                         { text: '接口', link: '/learn/advanced/interfaces' },
                         { text: '并发', link: '/learn/advanced/concurrency' },
                         { text: '泛型', link: '/learn/advanced/generics' },
-                        { text: '测试', link: '/learn/advanced/testing' }
+                        { text: '测试', link: '/learn/advanced/testing' },
+                        { text: '反射', link: '/learn/advanced/reflection' },
+                        { text: '编码与序列化', link: '/learn/advanced/encoding' },
+                        { text: 'I/O 接口', link: '/learn/advanced/io' },
+                        { text: '原子操作', link: '/learn/advanced/atomic' },
+                        { text: '命令行参数', link: '/learn/advanced/flag' },
+                        { text: '信号与进程生命周期', link: '/learn/advanced/signals' },
+                        { text: '模板', link: '/learn/advanced/templates' },
+                        { text: '字符串与文本处理', link: '/learn/advanced/strings' },
+                        { text: '定时器与调度', link: '/learn/advanced/timers' },
+                        { text: '文件系统抽象', link: '/learn/advanced/fs' },
+                        { text: '网络编程', link: '/learn/advanced/networking' },
+                        { text: 'HTTP 客户端', link: '/learn/advanced/http-client' },
+                        { text: 'TLS 与证书', link: '/learn/advanced/tls' },
+                        { text: '现代密码学实践', link: '/learn/advanced/crypto' },
+                        { text: 'Goroutine 泄漏', link: '/learn/advanced/goroutine-leaks' },
+                        { text: '构建标签与交叉编译', link: '/learn/advanced/build-tags' },
+                        { text: '正则引擎原理', link: '/learn/advanced/regex-engine' },
+                        { text: '排序与容器', link: '/learn/advanced/containers' }
                     ]
                 },
                 {